@@ -32,6 +32,17 @@ type Token struct {
 	ParentIdx int // Index of parent token (-1 for root).
 }
 
+// SyntaxError describes malformed JSON input and the byte offset where it was detected.
+type SyntaxError struct {
+	Msg    string // Description of the error.
+	Offset int    // Byte offset in the input where the error was detected.
+}
+
+// Error implements the error interface.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at offset %d", e.Msg, e.Offset)
+}
+
 // Parser is the JSON tokenizer state.
 type Parser struct {
 	pos      int // Current position in the JSON string.
@@ -109,7 +120,7 @@ func (p *Parser) Parse(json []byte) (int, error) {
 	}
 	// Additional validation: Check for unclosed structures
 	if p.toksuper != -1 {
-		return 0, errors.New("unclosed object or array")
+		return 0, &SyntaxError{Msg: "unclosed object or array", Offset: p.tokens[p.toksuper].Start}
 	}
 	return p.toknext, nil
 }
@@ -132,6 +143,7 @@ func (p *Parser) allocToken(tok Token) error {
 }
 
 func (p *Parser) parseString(json []byte) error {
+	start := p.pos
 	p.pos++ // Skip opening quote.
 	tok := Token{Type: String, Start: p.pos, End: -1, ParentIdx: p.toksuper}
 	for p.pos < len(json) {
@@ -150,7 +162,7 @@ func (p *Parser) parseString(json []byte) error {
 		}
 		p.pos++
 	}
-	return errors.New("unclosed string")
+	return &SyntaxError{Msg: "unclosed string", Offset: start}
 }
 
 func (p *Parser) parsePrimitive(json []byte) error {
@@ -164,7 +176,7 @@ func (p *Parser) parsePrimitive(json []byte) error {
 	}
 	tok.End = p.pos
 	if tok.End == tok.Start {
-		return errors.New("empty primitive")
+		return &SyntaxError{Msg: "empty primitive", Offset: tok.Start}
 	}
 	if err := p.allocToken(tok); err != nil {
 		return err
@@ -204,16 +216,20 @@ func ParseParallel(json []byte, numTokens int) ([]Token, error) {
 		if i == numWorkers-1 {
 			end = len(json)
 		}
-		go func(i int, chunk []byte) {
+		go func(i, start int, chunk []byte) {
 			defer wg.Done()
 			p := NewParser(numTokens) // Use full numTokens per to avoid overflow.
 			_, err := p.Parse(chunk)
 			if err != nil {
+				var serr *SyntaxError
+				if errors.As(err, &serr) {
+					serr.Offset += start // Report offsets relative to the full input.
+				}
 				errs <- err
 				return
 			}
 			results[i] = p.Tokens()
-		}(i, json[start:end])
+		}(i, start, json[start:end])
 	}
 
 	wg.Wait()
@@ -2,6 +2,7 @@ package jsmngo
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 )
 
@@ -39,3 +40,25 @@ func TestParseStream(t *testing.T) {
 		t.Errorf("expected 3 tokens, got %d", len(tokens))
 	}
 }
+
+func TestParseSyntaxError(t *testing.T) {
+	tests := []struct {
+		json   string
+		offset int
+	}{
+		{`{"key": "value`, 8},
+		{`{"key": [1, 2`, 8},
+		{`{"key": "value"`, 0},
+	}
+	for _, tt := range tests {
+		p := NewParser(10)
+		_, err := p.Parse([]byte(tt.json))
+		var serr *SyntaxError
+		if !errors.As(err, &serr) {
+			t.Fatalf("%s: expected *SyntaxError, got %v", tt.json, err)
+		}
+		if serr.Offset != tt.offset {
+			t.Errorf("%s: expected offset %d, got %d", tt.json, tt.offset, serr.Offset)
+		}
+	}
+}